@@ -2,8 +2,12 @@ package util
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,7 +17,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/kubernetes/test/e2e/framework"
 )
@@ -22,11 +27,57 @@ import (
 type AdminAckTest struct {
 	Oc     *CLI
 	Config *restclient.Config
+
+	// TargetVersion, if set, is the release version the test uses to exercise the CVO's
+	// Upgradeable preconditions once a gate has been cleared: a patch (z-level) update within
+	// the current 4.y is expected to be admitted, while the y-level of TargetVersion is
+	// expected to be rejected for as long as the gate remains un-acked.
+	TargetVersion string
+
+	// Reporter, if set, receives a per-gate breakdown of the evaluation as Test progresses.
+	Reporter AdminAckReporter
+
+	// GateNameMatcher validates openshift-config-managed/admin-gates keys and extracts the
+	// embedded ack version, e.g. "ack-4.15-m" from "ack-4.15-my-feature". Defaults to defaultAdminAckGateRegexp, which
+	// matches majors 4 through 6.
+	GateNameMatcher *regexp.Regexp
+
+	// MinorSkew allows gates targeting up to currentVersion's minor plus MinorSkew to be
+	// treated as applicable, in addition to the current minor itself. This is useful when
+	// running the test mid-upgrade, between two minors, where both sets of gates are
+	// legitimately in play. Defaults to 0, i.e. only the current minor is applicable.
+	MinorSkew int
+
+	// GateTimeout bounds how long Test waits for each expected condition transition (Upgradeable
+	// going AdminAckRequired or true, and, when TargetVersion is set, Failing reflecting a
+	// precondition outcome). Defaults to defaultGateTimeout.
+	GateTimeout time.Duration
 }
 
-const adminAckGateFmt string = "^ack-[4-5][.]([0-9]{1,})-[^-]"
+// defaultGateTimeout matches the fixed budget the previous poll-based implementation allowed per
+// condition transition.
+const defaultGateTimeout = 3 * time.Minute
+
+// AdminAckReporter receives per-gate evaluation results as AdminAckTest.Test progresses, giving
+// CI systems a structured breakdown instead of having to parse framework.Logf/Failf output.
+type AdminAckReporter interface {
+	// RecordGate is called once per gate found in openshift-config-managed/admin-gates that Test
+	// processes before returning or aborting.
+	// applicable is false when the gate does not apply to the current (or target) version, in
+	// which case no further action was taken on it and priorAck/resultingUpgradeable/err are
+	// zero values. err is non-nil if waiting for Upgradeable to go AdminAckRequired failed; a
+	// failure in the z/y-stream update checks aborts Test without a RecordGate call for that gate.
+	RecordGate(name, description string, applicable bool, priorAck string, resultingUpgradeable configv1.ConditionStatus, err error)
+	// Finish is called once when Test returns, including when a failure aborts it before every
+	// gate has been processed, allowing the reporter to flush any buffered output.
+	Finish() error
+}
 
-var adminAckGateRegexp = regexp.MustCompile(adminAckGateFmt)
+// defaultAdminAckGateFmt is the default format for admin-gates keys, matching majors 4 through
+// 6. Callers that need to match a different range of majors can set AdminAckTest.GateNameMatcher.
+const defaultAdminAckGateFmt string = "^ack-[4-6][.]([0-9]{1,})-[^-]"
+
+var defaultAdminAckGateRegexp = regexp.MustCompile(defaultAdminAckGateFmt)
 
 // Test simply returns successfully if admin ack functionality is not part of the baseline being tested. Otherwise,
 // for each configured admin ack gate, test verifies the gate name format and that it contains a description. If
@@ -36,6 +87,16 @@ var adminAckGateRegexp = regexp.MustCompile(adminAckGateFmt)
 // admin-acks configmap to ack the given admin-ack gate. Once all gates have been ack'ed, the test waits for the
 // Upgradeable condition to change to true.
 func (t *AdminAckTest) Test(ctx context.Context) {
+	if t.Reporter != nil {
+		// Deferred so the report is still flushed when a gate fails to transition and
+		// framework.Failf aborts the rest of Test via Ginkgo's panic/recover, which is
+		// precisely the case CI dashboards most need the per-gate breakdown for.
+		defer func() {
+			if err := t.Reporter.Finish(); err != nil {
+				framework.Logf("Error finishing admin ack report, err=%v", err)
+			}
+		}()
+	}
 
 	gateCm, errMsg := getAdminGatesConfigMap(ctx, t.Oc)
 	if len(errMsg) != 0 {
@@ -50,48 +111,183 @@ func (t *AdminAckTest) Test(ctx context.Context) {
 	if len(errMsg) != 0 {
 		framework.Failf(errMsg)
 	}
+	gateNameMatcher := t.GateNameMatcher
+	if gateNameMatcher == nil {
+		gateNameMatcher = defaultAdminAckGateRegexp
+	}
+	gateTimeout := t.GateTimeout
+	if gateTimeout <= 0 {
+		gateTimeout = defaultGateTimeout
+	}
 	currentVersion := getCurrentVersion(ctx, t.Config)
 	var msg string
 	for k, v := range gateCm.Data {
-		ackVersion := adminAckGateRegexp.FindString(k)
+		ackVersion := gateNameMatcher.FindString(k)
 		if ackVersion == "" {
-			framework.Failf(fmt.Sprintf("Configmap openshift-config-managed/admin-gates gate %s has invalid format; must comply with %q.", k, adminAckGateFmt))
+			framework.Failf(fmt.Sprintf("Configmap openshift-config-managed/admin-gates gate %s has invalid format; must comply with %q.", k, gateNameMatcher.String()))
 		}
 		if v == "" {
 			framework.Failf(fmt.Sprintf("Configmap openshift-config-managed/admin-gates gate %s does not contain description.", k))
 		}
-		if !gateApplicableToCurrentVersion(ackVersion, currentVersion) {
+		currentApplicable := gateApplicableToCurrentVersion(ackVersion, currentVersion, t.MinorSkew)
+		targetBlocking := t.TargetVersion != "" && gateBlocksTargetUpgrade(ackVersion, currentVersion, t.TargetVersion)
+		if !currentApplicable && !targetBlocking {
+			if t.Reporter != nil {
+				t.Reporter.RecordGate(k, v, false, "", "", nil)
+			}
 			continue
 		}
-		if ackCm.Data[k] == "true" {
-			if upgradeableExplicitlyFalse(ctx, t.Config) {
-				if adminAckRequiredWithMessage(ctx, t.Config, v) {
-					framework.Failf(fmt.Sprintf("Gate %s has been ack'ed but Upgradeable is "+
-						"false with reason AdminAckRequired and message %q.", k, v))
-				}
-				framework.Logf(fmt.Sprintf("Gate %s has been ack'ed. Upgradeable is "+
-					"false but not due to this gate which would set reason AdminAckRequired with message %s.", k, v) +
-					" " + getUpgradeable(ctx, t.Config))
+		priorAck := ackCm.Data[k]
+		if currentApplicable && priorAck == "true" && upgradeableExplicitlyFalse(ctx, t.Config) {
+			if adminAckRequiredWithMessage(ctx, t.Config, v) {
+				framework.Failf(fmt.Sprintf("Gate %s has been ack'ed but Upgradeable is "+
+					"false with reason AdminAckRequired and message %q.", k, v))
 			}
-			// Clear admin ack configmap gate ack
+			framework.Logf(fmt.Sprintf("Gate %s has been ack'ed. Upgradeable is "+
+				"false but not due to this gate which would set reason AdminAckRequired with message %s.", k, v) +
+				" " + getUpgradeable(ctx, t.Config))
+		}
+		if priorAck == "true" {
+			// Clear any stale ack so the gate starts unacknowledged: both the
+			// waitForAdminAckRequired wait below (currentApplicable) and testZAndYStreamUpdates's
+			// y-stream rejection check (targetBlocking) depend on that, not just currentApplicable
+			// gates.
 			if errMsg = setAdminGate(ctx, k, "", t.Oc); len(errMsg) != 0 {
 				framework.Failf(errMsg)
 			}
 		}
-		if errMsg = waitForAdminAckRequired(ctx, t.Config, msg); len(errMsg) != 0 {
-			framework.Failf(errMsg)
+		if currentApplicable {
+			errMsg = withGateDeadline(ctx, gateTimeout, func(gateCtx context.Context) string {
+				return waitForAdminAckRequired(gateCtx, t.Config, msg)
+			})
+			if len(errMsg) != 0 {
+				if t.Reporter != nil {
+					t.Reporter.RecordGate(k, v, true, priorAck, getUpgradeableStatus(ctx, t.Config), fmt.Errorf(errMsg))
+				}
+				framework.Failf(errMsg)
+			}
 		}
-		// Update admin ack configmap with ack
-		if errMsg = setAdminGate(ctx, k, "true", t.Oc); len(errMsg) != 0 {
-			framework.Failf(errMsg)
+		// targetBlocking fires for a gate whose minor matches TargetVersion's, not
+		// currentVersion's: it is not (and must not be) ack'ed by the currentApplicable flow
+		// above, since that's exactly the precondition the y-stream upgrade attempt below needs
+		// to observe being enforced.
+		if targetBlocking {
+			t.testZAndYStreamUpdates(ctx, currentVersion, gateTimeout)
+		}
+		if currentApplicable {
+			// Update admin ack configmap with ack
+			if errMsg = setAdminGate(ctx, k, "true", t.Oc); len(errMsg) != 0 {
+				framework.Failf(errMsg)
+			}
+		}
+		if t.Reporter != nil {
+			t.Reporter.RecordGate(k, v, true, priorAck, getUpgradeableStatus(ctx, t.Config), nil)
 		}
 	}
-	if errMsg = waitForUpgradeable(ctx, t.Config); len(errMsg) != 0 {
+	errMsg = withGateDeadline(ctx, gateTimeout, func(gateCtx context.Context) string {
+		return waitForUpgradeable(gateCtx, t.Config)
+	})
+	if len(errMsg) != 0 {
 		framework.Failf(errMsg)
 	}
 	framework.Logf("Admin Ack verified")
 }
 
+// AdminAckGate is a single openshift-config-managed/admin-gates entry that is still
+// unacknowledged and applicable to the upgrade described by an AdminAckPlan.
+type AdminAckGate struct {
+	Name        string
+	Description string
+}
+
+// AdminAckPlan is the set of admin ack gates that must be acknowledged before a cluster can
+// proceed with an upgrade to the targetVersion given to PlanAdminAcks, which produces it.
+type AdminAckPlan struct {
+	Oc     *CLI
+	Config *restclient.Config
+
+	// Gates are the still-unacknowledged gates applicable to either the current version or the
+	// y-level of the target version.
+	Gates []AdminAckGate
+}
+
+// PlanAdminAcks reads openshift-config-managed/admin-gates and openshift-config/admin-acks and
+// returns the gates that apply to the cluster's current version or to the y-level of
+// targetVersion and have not yet been acknowledged. Callers can inspect the plan, e.g. to
+// surface required acknowledgements to an operator, before calling Apply to satisfy them all at
+// once.
+func PlanAdminAcks(ctx context.Context, oc *CLI, config *restclient.Config, targetVersion string) (*AdminAckPlan, error) {
+	plan := &AdminAckPlan{Oc: oc, Config: config}
+
+	gateCm, errMsg := getAdminGatesConfigMap(ctx, oc)
+	if len(errMsg) != 0 {
+		return nil, fmt.Errorf(errMsg)
+	}
+	if gateCm == nil || len(gateCm.Data) == 0 {
+		return plan, nil
+	}
+	ackCm, errMsg := getAdminAcksConfigMap(ctx, oc)
+	if len(errMsg) != 0 {
+		return nil, fmt.Errorf(errMsg)
+	}
+	currentVersion := getCurrentVersion(ctx, config)
+	for name, description := range gateCm.Data {
+		if ackCm.Data[name] == "true" {
+			continue
+		}
+		ackVersion := defaultAdminAckGateRegexp.FindString(name)
+		if ackVersion == "" {
+			return nil, fmt.Errorf("configmap openshift-config-managed/admin-gates gate %s has invalid format; must comply with %q", name, defaultAdminAckGateFmt)
+		}
+		// alreadyAcked is always false here, having already continued above otherwise; passed
+		// through so gateRelevantToPlan's branching lives in one place.
+		if !gateRelevantToPlan(ackVersion, currentVersion, targetVersion, false) {
+			continue
+		}
+		plan.Gates = append(plan.Gates, AdminAckGate{Name: name, Description: description})
+	}
+	return plan, nil
+}
+
+// gateRelevantToPlan reports whether a gate belongs in an AdminAckPlan: not yet acknowledged, and
+// either applicable to the cluster's current version or blocking the y-stream upgrade to
+// targetVersion.
+func gateRelevantToPlan(ackVersion, currentVersion, targetVersion string, alreadyAcked bool) bool {
+	if alreadyAcked {
+		return false
+	}
+	return gateApplicableToCurrentVersion(ackVersion, currentVersion, 0) || gateBlocksTargetUpgrade(ackVersion, currentVersion, targetVersion)
+}
+
+// Apply acknowledges every gate in the plan with a single server-side apply against
+// openshift-config/admin-acks, rather than the gate-by-gate read-modify-write loop setAdminGate
+// performs, and then waits for Upgradeable to go true.
+func (p *AdminAckPlan) Apply(ctx context.Context) error {
+	if len(p.Gates) == 0 {
+		return nil
+	}
+	data := map[string]string{}
+	for _, g := range p.Gates {
+		data[g.Name] = "true"
+	}
+	patch, err := json.Marshal(&corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "admin-acks", Namespace: "openshift-config"},
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling admin-acks patch: %w", err)
+	}
+	force := true
+	if _, err := p.Oc.AdminKubeClient().CoreV1().ConfigMaps("openshift-config").Patch(ctx, "admin-acks", types.ApplyPatchType, patch, metav1.PatchOptions{FieldManager: "admin-ack-planner", Force: &force}); err != nil {
+		return fmt.Errorf("unable to apply configmap openshift-config/admin-acks, err=%w", err)
+	}
+	if errMsg := waitForUpgradeable(ctx, p.Config); len(errMsg) != 0 {
+		return fmt.Errorf(errMsg)
+	}
+	return nil
+}
+
 // getClusterVersion returns the ClusterVersion object.
 func getClusterVersion(ctx context.Context, config *restclient.Config) *configv1.ClusterVersion {
 	c, err := configv1client.NewForConfig(config)
@@ -133,14 +329,44 @@ func getEffectiveMinor(version string) string {
 	return splits[1]
 }
 
-func gateApplicableToCurrentVersion(gateAckVersion string, currentVersion string) bool {
+// gateApplicableToCurrentVersion returns true if gateAckVersion's minor matches currentVersion's
+// minor. If minorSkew is positive, a gate whose minor is up to minorSkew releases ahead of
+// currentVersion's is also considered applicable, to support running the test while the cluster
+// is mid-transition between two minors.
+func gateApplicableToCurrentVersion(gateAckVersion string, currentVersion string, minorSkew int) bool {
 	parts := strings.Split(gateAckVersion, "-")
 	ackMinor := getEffectiveMinor(parts[1])
 	cvMinor := getEffectiveMinor(currentVersion)
 	if ackMinor == cvMinor {
 		return true
 	}
-	return false
+	if minorSkew <= 0 {
+		return false
+	}
+	ackMinorInt, err := strconv.Atoi(ackMinor)
+	if err != nil {
+		return false
+	}
+	cvMinorInt, err := strconv.Atoi(cvMinor)
+	if err != nil {
+		return false
+	}
+	return ackMinorInt > cvMinorInt && ackMinorInt <= cvMinorInt+minorSkew
+}
+
+// gateBlocksTargetUpgrade returns true if a gate acked against gateAckVersion is what stands
+// between currentVersion and targetVersion, i.e. the gate's minor matches targetVersion's minor
+// but currentVersion is on a different minor. This is the y-stream (minor) upgrade case; it
+// does not fire for a z-stream (patch) upgrade within the same minor as currentVersion.
+func gateBlocksTargetUpgrade(gateAckVersion string, currentVersion string, targetVersion string) bool {
+	cvMinor := getEffectiveMinor(currentVersion)
+	targetMinor := getEffectiveMinor(targetVersion)
+	if cvMinor == targetMinor {
+		return false
+	}
+	parts := strings.Split(gateAckVersion, "-")
+	ackMinor := getEffectiveMinor(parts[1])
+	return ackMinor == targetMinor
 }
 
 func getAdminGatesConfigMap(ctx context.Context, oc *CLI) (*corev1.ConfigMap, string) {
@@ -198,33 +424,274 @@ func setAdminGate(ctx context.Context, gateName string, gateValue string, oc *CL
 	return ""
 }
 
+// waitForAdminAckRequired watches (rather than polls) the ClusterVersion "version" object until
+// Upgradeable carries reason AdminAckRequired and a message containing message, or ctx's deadline
+// expires. Callers control the budget via ctx, e.g. context.WithTimeout. A missing Upgradeable
+// condition never satisfies this wait, since AdminAckRequired is itself what populates it.
 func waitForAdminAckRequired(ctx context.Context, config *restclient.Config, message string) string {
 	framework.Logf("Waiting for Upgradeable to be AdminAckRequired...")
-	if err := wait.PollImmediate(10*time.Second, 3*time.Minute, func() (bool, error) {
-		if adminAckRequiredWithMessage(ctx, config, message) {
-			return true, nil
-		}
-		return false, nil
-	}); err != nil {
-		return fmt.Sprintf("Error while waiting for Upgradeable to go AdminAckRequired with message %q, err=%v", message, err) +
-			" " + getUpgradeable(ctx, config)
+	err := watchForClusterVersionCondition(ctx, config, "", getUpgradeableStatusCondition, func(cond *configv1.ClusterOperatorStatusCondition) bool {
+		return cond != nil && strings.Contains(cond.Reason, "AdminAckRequired") && strings.Contains(cond.Message, message)
+	})
+	if err != nil {
+		return fmt.Sprintf("Error while waiting for Upgradeable to go AdminAckRequired with message %q, err=%v", message, err)
 	}
 	return ""
 }
 
+// waitForUpgradeable watches the ClusterVersion "version" object until Upgradeable is no longer
+// explicitly false, or ctx's deadline expires. A missing Upgradeable condition satisfies the wait
+// immediately, matching the pre-watch upgradeableExplicitlyFalse semantics where an absent
+// condition means the cluster isn't blocked.
 func waitForUpgradeable(ctx context.Context, config *restclient.Config) string {
 	framework.Logf("Waiting for Upgradeable true...")
-	if err := wait.PollImmediate(10*time.Second, 3*time.Minute, func() (bool, error) {
-		if !upgradeableExplicitlyFalse(ctx, config) {
-			return true, nil
+	err := watchForClusterVersionCondition(ctx, config, "", getUpgradeableStatusCondition, func(cond *configv1.ClusterOperatorStatusCondition) bool {
+		return cond == nil || cond.Status != configv1.ConditionFalse
+	})
+	if err != nil {
+		return fmt.Sprintf("Error while waiting for Upgradeable to go true, err=%v", err)
+	}
+	return ""
+}
+
+// testZAndYStreamUpdates exercises the CVO's Upgradeable preconditions while a gate is still
+// outstanding: setting spec.desiredUpdate to a patch (z-level) release within currentVersion's
+// 4.y is expected to be admitted, while setting it to TargetVersion, whose 4.y differs from
+// currentVersion's, is expected to be rejected with an AdminAckRequired-derived precondition
+// failure surfaced via the Failing status condition. gateTimeout bounds each condition wait.
+//
+// The target-blocking gate this exercises is still un-acked when this returns, so on return
+// spec.desiredUpdate is restored to whatever it was before this call and the Failing condition is
+// waited back to clear, rather than leaving the cluster pointed at a mock release other specs or
+// monitoring might trip over.
+func (t *AdminAckTest) testZAndYStreamUpdates(ctx context.Context, currentVersion string, gateTimeout time.Duration) {
+	originalDesiredUpdate := getClusterVersion(ctx, t.Config).Spec.DesiredUpdate.DeepCopy()
+	defer func() {
+		restoreRV, errMsg := setDesiredUpdate(ctx, t.Config, originalDesiredUpdate)
+		if len(errMsg) != 0 {
+			framework.Failf(errMsg)
 		}
-		return false, nil
-	}); err != nil {
-		return fmt.Sprintf("Error while waiting for Upgradeable to go true, err=%v", err) + " " + getUpgradeable(ctx, config)
+		errMsg = withGateDeadline(ctx, gateTimeout, func(gateCtx context.Context) string {
+			return waitForFailingAdminAckRequired(gateCtx, t.Config, false, restoreRV)
+		})
+		if len(errMsg) != 0 {
+			framework.Failf(fmt.Sprintf("Error restoring desired update after z/y-stream exercise. %s", errMsg))
+		}
+	}()
+
+	zStreamVersion := bumpZStream(currentVersion)
+	zStreamRV, errMsg := setDesiredUpdate(ctx, t.Config, mockUpdate(zStreamVersion))
+	if len(errMsg) != 0 {
+		framework.Failf(errMsg)
+	}
+	errMsg = withGateDeadline(ctx, gateTimeout, func(gateCtx context.Context) string {
+		return waitForFailingAdminAckRequired(gateCtx, t.Config, false, zStreamRV)
+	})
+	if len(errMsg) != 0 {
+		framework.Failf(fmt.Sprintf("Expected z-stream update to %s to be admitted while gate is outstanding. %s", zStreamVersion, errMsg))
+	}
+
+	targetRV, errMsg := setDesiredUpdate(ctx, t.Config, mockUpdate(t.TargetVersion))
+	if len(errMsg) != 0 {
+		framework.Failf(errMsg)
+	}
+	errMsg = withGateDeadline(ctx, gateTimeout, func(gateCtx context.Context) string {
+		return waitForFailingAdminAckRequired(gateCtx, t.Config, true, targetRV)
+	})
+	if len(errMsg) != 0 {
+		framework.Failf(fmt.Sprintf("Expected y-stream update to %s to be rejected while gate is outstanding. %s", t.TargetVersion, errMsg))
+	}
+}
+
+// withGateDeadline runs fn with a child context bounded by timeout, so each condition wait gets
+// its own realistic per-gate budget carved out of the caller's overall ctx.
+func withGateDeadline(ctx context.Context, timeout time.Duration, fn func(context.Context) string) string {
+	gateCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return fn(gateCtx)
+}
+
+// bumpZStream returns version with its patch component incremented by one, e.g. 4.12.3 becomes
+// 4.12.4. If version does not parse as a three-component semantic version, version is returned
+// unchanged.
+func bumpZStream(version string) string {
+	parts := strings.Split(version, ".")
+	if len(parts) < 3 {
+		return version
+	}
+	z, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return version
+	}
+	parts[2] = strconv.Itoa(z + 1)
+	return strings.Join(parts, ".")
+}
+
+// mockUpdate returns a ClusterVersion Update pointing at a mock release matching version, for
+// exercising CVO upgrade preconditions without an actual release payload.
+func mockUpdate(version string) *configv1.Update {
+	return &configv1.Update{
+		Version: version,
+		Image:   fmt.Sprintf("registry.ci.openshift.org/ocp/release:%s", version),
+	}
+}
+
+// setDesiredUpdate sets the ClusterVersion's spec.desiredUpdate to update with a get-then-Update
+// read-modify-write, triggering the CVO
+// to re-evaluate upgrade preconditions, and returns the ResourceVersion the update was made at so
+// callers can tell a subsequent wait apart from a stale, pre-update snapshot of the object. update
+// may be nil to clear spec.desiredUpdate, e.g. to restore it to its pre-test value.
+func setDesiredUpdate(ctx context.Context, config *restclient.Config, update *configv1.Update) (string, string) {
+	c, err := configv1client.NewForConfig(config)
+	if err != nil {
+		return "", fmt.Sprintf("Error getting config, err=%v", err)
+	}
+	cv, err := c.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Sprintf("Error getting cluster version, err=%v", err)
+	}
+	cv.Spec.DesiredUpdate = update
+	updated, err := c.ConfigV1().ClusterVersions().Update(ctx, cv, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Sprintf("Error setting desired update to %v, err=%v", update, err)
+	}
+	return updated.ResourceVersion, ""
+}
+
+// waitForFailingAdminAckRequired watches the ClusterVersion "version" object until the Failing
+// condition's AdminAckRequired-ness matches wantFailing, or ctx's deadline expires. wantFailing
+// true means the just-requested desired update is expected to be rejected. afterResourceVersion
+// is the ResourceVersion the desired update was set at (from setDesiredUpdate); observations at or
+// before it are ignored, since the CVO may not have reconciled the new spec yet and a quiescent
+// Failing condition left over from before the update would otherwise satisfy the wait trivially.
+func waitForFailingAdminAckRequired(ctx context.Context, config *restclient.Config, wantFailing bool, afterResourceVersion string) string {
+	framework.Logf("Waiting for Failing condition to reflect AdminAckRequired precondition evaluation...")
+	err := watchForClusterVersionCondition(ctx, config, afterResourceVersion, getFailingStatusCondition, func(cond *configv1.ClusterOperatorStatusCondition) bool {
+		isFailingWithAdminAck := cond != nil && cond.Status == configv1.ConditionTrue && strings.Contains(cond.Reason, "AdminAckRequired")
+		return isFailingWithAdminAck == wantFailing
+	})
+	if err != nil {
+		return fmt.Sprintf("Error while waiting for Failing condition to go AdminAckRequired=%t, err=%v", wantFailing, err)
 	}
 	return ""
 }
 
+func getFailingStatusCondition(conditions []configv1.ClusterOperatorStatusCondition) *configv1.ClusterOperatorStatusCondition {
+	for _, condition := range conditions {
+		if condition.Type == "Failing" {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// conditionObservation is a single (time, status, reason, message) tuple recorded while watching
+// for a ClusterVersion condition to transition, so a post-mortem shows exactly when and why the
+// condition changed.
+type conditionObservation struct {
+	Time    time.Time
+	Status  configv1.ConditionStatus
+	Reason  string
+	Message string
+}
+
+func (o conditionObservation) String() string {
+	return fmt.Sprintf("[%s] Status=%s Reason=%s Message=%q", o.Time.Format(time.RFC3339), o.Status, o.Reason, o.Message)
+}
+
+// watchForClusterVersionCondition watches the ClusterVersion "version" object, applying extract
+// to pull out the condition of interest from each observed state, until want is satisfied or ctx
+// is done. want is always called, even when extract returns nil, so callers can decide for
+// themselves whether a missing condition counts as satisfying the wait. Every non-nil extracted
+// condition is recorded, and on timeout the full history is included in the returned error.
+//
+// If afterResourceVersion is non-empty, observations at or before that ResourceVersion are
+// ignored for the purposes of satisfying want (though they are still recorded): it marks the
+// point at which a caller mutated the object (e.g. via setDesiredUpdate), and a snapshot from
+// before or exactly at that mutation cannot yet reflect the CVO having reconciled it.
+func watchForClusterVersionCondition(
+	ctx context.Context,
+	config *restclient.Config,
+	afterResourceVersion string,
+	extract func([]configv1.ClusterOperatorStatusCondition) *configv1.ClusterOperatorStatusCondition,
+	want func(*configv1.ClusterOperatorStatusCondition) bool,
+) error {
+	c, err := configv1client.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("error getting config, err=%w", err)
+	}
+
+	var history []conditionObservation
+	satisfies := func(cv *configv1.ClusterVersion) bool {
+		cond := extract(cv.Status.Conditions)
+		if cond != nil {
+			history = append(history, conditionObservation{Time: time.Now(), Status: cond.Status, Reason: cond.Reason, Message: cond.Message})
+		}
+		if afterResourceVersion != "" && !resourceVersionNewerThan(cv.ResourceVersion, afterResourceVersion) {
+			return false
+		}
+		return want(cond)
+	}
+
+	cv := getClusterVersion(ctx, config)
+	if satisfies(cv) {
+		return nil
+	}
+
+	watchResourceVersion := cv.ResourceVersion
+	if afterResourceVersion != "" {
+		watchResourceVersion = afterResourceVersion
+	}
+	watcher, err := c.ConfigV1().ClusterVersions().Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", "version").String(),
+		ResourceVersion: watchResourceVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("error watching cluster version, err=%w", err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return historyError(history, fmt.Errorf("watch channel closed before condition was satisfied"))
+			}
+			cv, ok := event.Object.(*configv1.ClusterVersion)
+			if !ok {
+				continue
+			}
+			if satisfies(cv) {
+				return nil
+			}
+		case <-ctx.Done():
+			return historyError(history, ctx.Err())
+		}
+	}
+}
+
+// resourceVersionNewerThan reports whether candidate is a ClusterVersion ResourceVersion observed
+// strictly after baseline. ResourceVersions are opaque per the API contract, but in practice are
+// monotonically increasing etcd revisions, so they're compared numerically; if either fails to
+// parse, a differing value is conservatively treated as newer rather than risk waiting forever on
+// a comparison that can never succeed.
+func resourceVersionNewerThan(candidate, baseline string) bool {
+	c, cErr := strconv.ParseUint(candidate, 10, 64)
+	b, bErr := strconv.ParseUint(baseline, 10, 64)
+	if cErr != nil || bErr != nil {
+		return candidate != baseline
+	}
+	return c > b
+}
+
+func historyError(history []conditionObservation, cause error) error {
+	lines := make([]string, 0, len(history))
+	for _, o := range history {
+		lines = append(lines, o.String())
+	}
+	return fmt.Errorf("%w; observed condition history:\n%s", cause, strings.Join(lines, "\n"))
+}
+
 func getUpgradeableStatusCondition(conditions []configv1.ClusterOperatorStatusCondition) *configv1.ClusterOperatorStatusCondition {
 	for _, condition := range conditions {
 		if condition.Type == configv1.OperatorUpgradeable {
@@ -234,6 +701,17 @@ func getUpgradeableStatusCondition(conditions []configv1.ClusterOperatorStatusCo
 	return nil
 }
 
+// getUpgradeableStatus returns the ClusterVersion's Upgradeable condition status, or
+// configv1.ConditionUnknown if the condition is not present.
+func getUpgradeableStatus(ctx context.Context, config *restclient.Config) configv1.ConditionStatus {
+	clusterVersion := getClusterVersion(ctx, config)
+	cond := getUpgradeableStatusCondition(clusterVersion.Status.Conditions)
+	if cond == nil {
+		return configv1.ConditionUnknown
+	}
+	return cond.Status
+}
+
 func getUpgradeable(ctx context.Context, config *restclient.Config) string {
 	clusterVersion := getClusterVersion(ctx, config)
 	cond := getUpgradeableStatusCondition(clusterVersion.Status.Conditions)
@@ -242,3 +720,108 @@ func getUpgradeable(ctx context.Context, config *restclient.Config) string {
 	}
 	return "Upgradeable nil"
 }
+
+// adminAckReportSchemaVersion is bumped whenever AdminAckJSONReport's shape changes in a
+// backwards-incompatible way, so consumers can detect documents they don't understand.
+const adminAckReportSchemaVersion = 1
+
+// AdminAckGateResult is the recorded outcome for a single gate processed by AdminAckTest.Test.
+type AdminAckGateResult struct {
+	Name                 string                   `json:"name"`
+	Description          string                   `json:"description"`
+	Applicable           bool                     `json:"applicable"`
+	PriorAck             string                   `json:"priorAck,omitempty"`
+	ResultingUpgradeable configv1.ConditionStatus `json:"resultingUpgradeable,omitempty"`
+	Error                string                   `json:"error,omitempty"`
+}
+
+// AdminAckJSONReport is the schema-versioned document written by AdminAckJSONReporter.
+type AdminAckJSONReport struct {
+	SchemaVersion int                  `json:"schemaVersion"`
+	Gates         []AdminAckGateResult `json:"gates"`
+}
+
+// AdminAckJSONReporter implements AdminAckReporter by buffering gate results and writing them,
+// on Finish, as a single schema-versioned JSON document to Writer.
+type AdminAckJSONReporter struct {
+	Writer io.Writer
+
+	gates []AdminAckGateResult
+}
+
+func (r *AdminAckJSONReporter) RecordGate(name, description string, applicable bool, priorAck string, resultingUpgradeable configv1.ConditionStatus, err error) {
+	result := AdminAckGateResult{
+		Name:                 name,
+		Description:          description,
+		Applicable:           applicable,
+		PriorAck:             priorAck,
+		ResultingUpgradeable: resultingUpgradeable,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	r.gates = append(r.gates, result)
+}
+
+func (r *AdminAckJSONReporter) Finish() error {
+	return json.NewEncoder(r.Writer).Encode(AdminAckJSONReport{
+		SchemaVersion: adminAckReportSchemaVersion,
+		Gates:         r.gates,
+	})
+}
+
+// adminAckJUnitTestSuite and adminAckJUnitTestCase model just enough of the JUnit XML schema
+// for CI dashboards to render one testcase per admin ack gate.
+type adminAckJUnitTestSuite struct {
+	XMLName  xml.Name                `xml:"testsuite"`
+	Name     string                  `xml:"name,attr"`
+	Tests    int                     `xml:"tests,attr"`
+	Failures int                     `xml:"failures,attr"`
+	Cases    []adminAckJUnitTestCase `xml:"testcase"`
+}
+
+type adminAckJUnitTestCase struct {
+	Name      string                `xml:"name,attr"`
+	ClassName string                `xml:"classname,attr"`
+	Failure   *adminAckJUnitFailure `xml:"failure,omitempty"`
+}
+
+type adminAckJUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// AdminAckJUnitReporter implements AdminAckReporter by emitting one JUnit testcase per gate,
+// wrapped in a single testsuite, to Writer on Finish.
+type AdminAckJUnitReporter struct {
+	Writer io.Writer
+
+	cases []adminAckJUnitTestCase
+}
+
+func (r *AdminAckJUnitReporter) RecordGate(name, description string, applicable bool, priorAck string, resultingUpgradeable configv1.ConditionStatus, err error) {
+	tc := adminAckJUnitTestCase{Name: name, ClassName: "admin_ack"}
+	if err != nil {
+		tc.Failure = &adminAckJUnitFailure{Message: err.Error(), Text: description}
+	}
+	r.cases = append(r.cases, tc)
+}
+
+func (r *AdminAckJUnitReporter) Finish() error {
+	failures := 0
+	for _, c := range r.cases {
+		if c.Failure != nil {
+			failures++
+		}
+	}
+	suite := adminAckJUnitTestSuite{Name: "admin_ack", Tests: len(r.cases), Failures: failures, Cases: r.cases}
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := r.Writer.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	_, err = r.Writer.Write(out)
+	return err
+}
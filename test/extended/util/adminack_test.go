@@ -0,0 +1,102 @@
+package util
+
+import "testing"
+
+func TestDefaultAdminAckGateRegexpMatchesMultipleMajors(t *testing.T) {
+	tests := []struct {
+		name    string
+		gate    string
+		matches bool
+	}{
+		{name: "major 4", gate: "ack-4.15-my-feature", matches: true},
+		{name: "major 5", gate: "ack-5.2-my-feature", matches: true},
+		{name: "major 6", gate: "ack-6.0-my-feature", matches: true},
+		{name: "major 7 not yet supported", gate: "ack-7.0-my-feature", matches: false},
+		{name: "missing minor", gate: "ack-4-my-feature", matches: false},
+		{name: "missing trailing segment", gate: "ack-4.15-", matches: false},
+		{name: "wrong prefix", gate: "gate-4.15-my-feature", matches: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := defaultAdminAckGateRegexp.FindString(tc.gate) != ""
+			if got != tc.matches {
+				t.Errorf("FindString(%q): got matches=%v, want %v", tc.gate, got, tc.matches)
+			}
+		})
+	}
+}
+
+func TestGateApplicableToCurrentVersionMinorSkew(t *testing.T) {
+	tests := []struct {
+		name           string
+		gateAckVersion string
+		currentVersion string
+		minorSkew      int
+		applicable     bool
+	}{
+		{name: "same minor, no skew", gateAckVersion: "ack-4.15-x", currentVersion: "4.15.3", minorSkew: 0, applicable: true},
+		{name: "different minor, no skew", gateAckVersion: "ack-4.16-x", currentVersion: "4.15.3", minorSkew: 0, applicable: false},
+		{name: "one minor ahead, within skew", gateAckVersion: "ack-4.16-x", currentVersion: "4.15.3", minorSkew: 1, applicable: true},
+		{name: "two minors ahead, skew of one", gateAckVersion: "ack-4.17-x", currentVersion: "4.15.3", minorSkew: 1, applicable: false},
+		{name: "minor behind current is never applicable via skew", gateAckVersion: "ack-4.14-x", currentVersion: "4.15.3", minorSkew: 1, applicable: false},
+		{name: "exact skew boundary", gateAckVersion: "ack-4.17-x", currentVersion: "4.15.3", minorSkew: 2, applicable: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gateApplicableToCurrentVersion(tc.gateAckVersion, tc.currentVersion, tc.minorSkew)
+			if got != tc.applicable {
+				t.Errorf("gateApplicableToCurrentVersion(%q, %q, %d): got %v, want %v",
+					tc.gateAckVersion, tc.currentVersion, tc.minorSkew, got, tc.applicable)
+			}
+		})
+	}
+}
+
+func TestGateBlocksTargetUpgrade(t *testing.T) {
+	tests := []struct {
+		name           string
+		gateAckVersion string
+		currentVersion string
+		targetVersion  string
+		blocks         bool
+	}{
+		{name: "same minor is a z-stream update, never blocking", gateAckVersion: "ack-4.15-x", currentVersion: "4.15.3", targetVersion: "4.15.9", blocks: false},
+		{name: "gate matches target's minor", gateAckVersion: "ack-4.16-x", currentVersion: "4.15.3", targetVersion: "4.16.0", blocks: true},
+		{name: "gate matches current's minor, not target's", gateAckVersion: "ack-4.15-x", currentVersion: "4.15.3", targetVersion: "4.16.0", blocks: false},
+		{name: "gate matches neither minor", gateAckVersion: "ack-4.14-x", currentVersion: "4.15.3", targetVersion: "4.16.0", blocks: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gateBlocksTargetUpgrade(tc.gateAckVersion, tc.currentVersion, tc.targetVersion)
+			if got != tc.blocks {
+				t.Errorf("gateBlocksTargetUpgrade(%q, %q, %q): got %v, want %v",
+					tc.gateAckVersion, tc.currentVersion, tc.targetVersion, got, tc.blocks)
+			}
+		})
+	}
+}
+
+func TestGateRelevantToPlan(t *testing.T) {
+	tests := []struct {
+		name           string
+		gateAckVersion string
+		currentVersion string
+		targetVersion  string
+		alreadyAcked   bool
+		relevant       bool
+	}{
+		{name: "same minor, unacked", gateAckVersion: "ack-4.15-x", currentVersion: "4.15.3", targetVersion: "4.16.0", alreadyAcked: false, relevant: true},
+		{name: "target ahead, unacked", gateAckVersion: "ack-4.16-x", currentVersion: "4.15.3", targetVersion: "4.16.0", alreadyAcked: false, relevant: true},
+		{name: "already acked gate excluded even though applicable", gateAckVersion: "ack-4.15-x", currentVersion: "4.15.3", targetVersion: "4.16.0", alreadyAcked: true, relevant: false},
+		{name: "neither applicable nor target-blocking", gateAckVersion: "ack-4.14-x", currentVersion: "4.15.3", targetVersion: "4.16.0", alreadyAcked: false, relevant: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gateRelevantToPlan(tc.gateAckVersion, tc.currentVersion, tc.targetVersion, tc.alreadyAcked)
+			if got != tc.relevant {
+				t.Errorf("gateRelevantToPlan(%q, %q, %q, %v): got %v, want %v",
+					tc.gateAckVersion, tc.currentVersion, tc.targetVersion, tc.alreadyAcked, got, tc.relevant)
+			}
+		})
+	}
+}